@@ -0,0 +1,83 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// BankKeeper defines the subset of the bank keeper's functionality needed by
+// AuxTxDecorator to move a tip from the tipper to the tx's fee payer.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// AuxTxDecorator collects the tip declared by an AuxSignerData-backed tx,
+// transferring it from the tipper to the tx's fee payer before the fee
+// payer's own fee is deducted further down the ante chain. It is what makes
+// the `tx aux-sign` / `tx broadcast-tipped` CLI commands actually pay out:
+// aux-sign lets a tipper sign only over the tip and msgs, and
+// broadcast-tipped lets a fee-payer combine that with their own signature
+// and gas payment. It refuses to move funds unless tip.Tipper is one of the
+// tx's required signers, and it must be placed after SigVerificationDecorator
+// in the ante chain so that "required signer" also means "verified
+// signature" by the time it runs — placed anywhere else, GetSigners()
+// membership alone doesn't prove tip.Tipper actually signed.
+type AuxTxDecorator struct {
+	bankKeeper BankKeeper
+}
+
+// NewAuxTxDecorator returns an AuxTxDecorator collecting tips through
+// bankKeeper. It must be placed in the ante handler chain after
+// SigVerificationDecorator and before fee deduction.
+func NewAuxTxDecorator(bankKeeper BankKeeper) AuxTxDecorator {
+	return AuxTxDecorator{bankKeeper: bankKeeper}
+}
+
+func (d AuxTxDecorator) AnteHandle(ctx sdk.Context, sdkTx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	tipTx, ok := sdkTx.(interface{ GetTip() *tx.Tip })
+	if !ok {
+		return next(ctx, sdkTx, simulate)
+	}
+
+	tip := tipTx.GetTip()
+	if tip == nil || tip.Amount.IsZero() {
+		return next(ctx, sdkTx, simulate)
+	}
+
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx with a tip must be a FeeTx")
+	}
+
+	tipper, err := sdk.AccAddressFromBech32(tip.Tipper)
+	if err != nil {
+		return ctx, sdkerrors.Wrap(err, "invalid tipper address")
+	}
+
+	sigTx, ok := sdkTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx with a tip must support signature verification")
+	}
+	if !isSigner(tipper, sigTx.GetSigners()) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "tip.Tipper %s did not sign the tx", tip.Tipper)
+	}
+
+	if !simulate {
+		if err := d.bankKeeper.SendCoins(ctx, tipper, feeTx.FeePayer(), tip.Amount); err != nil {
+			return ctx, sdkerrors.Wrap(err, "failed to collect tip from tipper")
+		}
+	}
+
+	return next(ctx, sdkTx, simulate)
+}
+
+func isSigner(addr sdk.AccAddress, signers []sdk.AccAddress) bool {
+	for _, signer := range signers {
+		if signer.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package ante
+
+import (
+	"testing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type fakeTippedFeeTx struct {
+	tip      *tx.Tip
+	fee      sdk.Coins
+	feePayer sdk.AccAddress
+	signers  []sdk.AccAddress
+}
+
+func (t fakeTippedFeeTx) GetMsgs() []sdk.Msg    { return nil }
+func (t fakeTippedFeeTx) ValidateBasic() error  { return nil }
+func (t fakeTippedFeeTx) GetGas() uint64        { return 0 }
+func (t fakeTippedFeeTx) GetFee() sdk.Coins     { return t.fee }
+func (t fakeTippedFeeTx) FeePayer() sdk.AccAddress   { return t.feePayer }
+func (t fakeTippedFeeTx) FeeGranter() sdk.AccAddress { return nil }
+func (t fakeTippedFeeTx) GetTip() *tx.Tip       { return t.tip }
+func (t fakeTippedFeeTx) GetSigners() []sdk.AccAddress { return t.signers }
+func (t fakeTippedFeeTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return nil, nil }
+func (t fakeTippedFeeTx) GetSignaturesV2() ([]signingtypes.SignatureV2, error) { return nil, nil }
+
+type fakeBankKeeper struct {
+	sent bool
+	from sdk.AccAddress
+	to   sdk.AccAddress
+	amt  sdk.Coins
+	err  error
+}
+
+func (k *fakeBankKeeper) SendCoins(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.err != nil {
+		return k.err
+	}
+	k.sent = true
+	k.from = fromAddr
+	k.to = toAddr
+	k.amt = amt
+	return nil
+}
+
+func noopNext(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+func TestAuxTxDecoratorCollectsTip(t *testing.T) {
+	tipper := sdk.AccAddress(make([]byte, 20))
+	feePayer := sdk.AccAddress(append(make([]byte, 19), 1))
+	tip := sdk.NewCoins(sdk.NewInt64Coin("utia", 100))
+
+	bk := &fakeBankKeeper{}
+	decorator := NewAuxTxDecorator(bk)
+
+	fakeTx := fakeTippedFeeTx{
+		tip:      &tx.Tip{Amount: tip, Tipper: tipper.String()},
+		feePayer: feePayer,
+		signers:  []sdk.AccAddress{tipper, feePayer},
+	}
+
+	if _, err := decorator.AnteHandle(sdk.Context{}, fakeTx, false, noopNext); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !bk.sent {
+		t.Fatal("expected SendCoins to be called")
+	}
+	if !bk.from.Equals(tipper) || !bk.to.Equals(feePayer) || !bk.amt.IsEqual(tip) {
+		t.Fatalf("unexpected SendCoins args: from=%s to=%s amt=%s", bk.from, bk.to, bk.amt)
+	}
+}
+
+func TestAuxTxDecoratorRejectsForgedTipper(t *testing.T) {
+	tipper := sdk.AccAddress(make([]byte, 20))
+	feePayer := sdk.AccAddress(append(make([]byte, 19), 1))
+	tip := sdk.NewCoins(sdk.NewInt64Coin("utia", 100))
+
+	bk := &fakeBankKeeper{}
+	decorator := NewAuxTxDecorator(bk)
+
+	// tip.Tipper names an address that never signed the tx: the only
+	// required/verified signer is the fee payer.
+	fakeTx := fakeTippedFeeTx{
+		tip:      &tx.Tip{Amount: tip, Tipper: tipper.String()},
+		feePayer: feePayer,
+		signers:  []sdk.AccAddress{feePayer},
+	}
+
+	if _, err := decorator.AnteHandle(sdk.Context{}, fakeTx, false, noopNext); err == nil {
+		t.Fatal("expected an error for a tipper that never signed the tx")
+	}
+	if bk.sent {
+		t.Fatal("expected SendCoins not to be called for a forged tipper")
+	}
+}
+
+func TestAuxTxDecoratorSkipsUntippedTx(t *testing.T) {
+	bk := &fakeBankKeeper{}
+	decorator := NewAuxTxDecorator(bk)
+
+	fakeTx := fakeTippedFeeTx{tip: nil, feePayer: sdk.AccAddress(make([]byte, 20))}
+
+	if _, err := decorator.AnteHandle(sdk.Context{}, fakeTx, false, noopNext); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if bk.sent {
+		t.Fatal("expected SendCoins not to be called for an untipped tx")
+	}
+}
@@ -0,0 +1,135 @@
+package ante
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// timeoutTimestampExtensionTypeURL identifies the Any carrying a
+// TimeoutTimestampExtensionOption among a tx's extension options. TxBody has
+// no native timeout-timestamp field, so `tx broadcast-tipped` threads the
+// CLI's --timeout-timestamp flag onto the tx this way instead.
+const timeoutTimestampExtensionTypeURL = "/celestia.app.ante.TimeoutTimestampExtensionOption"
+
+// TimeoutTimestampExtensionOption carries a deadline (unix nanos) after
+// which the tx is no longer valid. Zero means no deadline.
+type TimeoutTimestampExtensionOption struct {
+	Timestamp int64
+}
+
+// Marshal encodes o as a single-field protobuf message (field 1, varint).
+// It's hand-written rather than generated, since this option is only ever
+// produced and consumed within this package.
+func (o TimeoutTimestampExtensionOption) Marshal() ([]byte, error) {
+	if o.Timestamp == 0 {
+		return nil, nil
+	}
+	buf := []byte{0x08} // field 1, wire type 0 (varint)
+	return appendVarint(buf, uint64(o.Timestamp)), nil
+}
+
+// Unmarshal decodes bz produced by Marshal.
+func (o *TimeoutTimestampExtensionOption) Unmarshal(bz []byte) error {
+	o.Timestamp = 0
+	if len(bz) == 0 {
+		return nil
+	}
+	if bz[0] != 0x08 {
+		return fmt.Errorf("ante: unexpected tag %#x in TimeoutTimestampExtensionOption", bz[0])
+	}
+	v, err := readVarint(bz[1:])
+	if err != nil {
+		return err
+	}
+	o.Timestamp = int64(v)
+	return nil
+}
+
+func appendVarint(buf []byte, v uint64) []byte {
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
+func readVarint(bz []byte) (uint64, error) {
+	var v uint64
+	var shift uint
+	for _, b := range bz {
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v, nil
+		}
+		shift += 7
+	}
+	return 0, fmt.Errorf("ante: truncated varint in TimeoutTimestampExtensionOption")
+}
+
+// SetTimeoutTimestampExtensionOption attaches timestamp (unix nanos) to
+// builder as a critical extension option, so a node that doesn't know how to
+// interpret it rejects the tx outright instead of silently skipping
+// enforcement. timestamp of 0 is a no-op.
+func SetTimeoutTimestampExtensionOption(builder authtx.ExtensionOptionsTxBuilder, timestamp int64) error {
+	if timestamp == 0 {
+		return nil
+	}
+	bz, err := TimeoutTimestampExtensionOption{Timestamp: timestamp}.Marshal()
+	if err != nil {
+		return err
+	}
+	builder.SetExtensionOptions(&codectypes.Any{
+		TypeUrl: timeoutTimestampExtensionTypeURL,
+		Value:   bz,
+	})
+	return nil
+}
+
+// getTimeoutTimestamp returns the timeout-timestamp (unix nanos) carried by
+// sdkTx's extension options, and whether one was present.
+func getTimeoutTimestamp(sdkTx sdk.Tx) (int64, bool, error) {
+	extTx, ok := sdkTx.(interface{ GetExtensionOptions() []*codectypes.Any })
+	if !ok {
+		return 0, false, nil
+	}
+	for _, any := range extTx.GetExtensionOptions() {
+		if any.TypeUrl != timeoutTimestampExtensionTypeURL {
+			continue
+		}
+		var opt TimeoutTimestampExtensionOption
+		if err := opt.Unmarshal(any.Value); err != nil {
+			return 0, false, err
+		}
+		return opt.Timestamp, true, nil
+	}
+	return 0, false, nil
+}
+
+// TimeoutTimestampDecorator rejects a tx whose TimeoutTimestampExtensionOption
+// deadline has already passed as of the block it would be included in. It is
+// the server-side counterpart to the CLI's --timeout-timestamp flag: the
+// client only gets to reject an already-past deadline before the tx is even
+// built (see checkTimeoutTimestampFlag in cmd/celestia-appd/cmd); this
+// decorator is what actually enforces the deadline once the tx reaches the
+// chain.
+type TimeoutTimestampDecorator struct{}
+
+// NewTimeoutTimestampDecorator returns a TimeoutTimestampDecorator.
+func NewTimeoutTimestampDecorator() TimeoutTimestampDecorator {
+	return TimeoutTimestampDecorator{}
+}
+
+func (d TimeoutTimestampDecorator) AnteHandle(ctx sdk.Context, sdkTx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	timeout, ok, err := getTimeoutTimestamp(sdkTx)
+	if err != nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	if ok && timeout != 0 && ctx.BlockTime().UnixNano() > timeout {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tx timeout-timestamp %d has elapsed; block time %s", timeout, ctx.BlockTime())
+	}
+	return next(ctx, sdkTx, simulate)
+}
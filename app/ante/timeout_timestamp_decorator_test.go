@@ -0,0 +1,76 @@
+package ante
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+type fakeExtensionTx struct {
+	extOpts []*codectypes.Any
+}
+
+func (t fakeExtensionTx) GetMsgs() []sdk.Msg                              { return nil }
+func (t fakeExtensionTx) ValidateBasic() error                            { return nil }
+func (t fakeExtensionTx) GetExtensionOptions() []*codectypes.Any          { return t.extOpts }
+
+func newFakeExtensionTx(t *testing.T, timeout int64) fakeExtensionTx {
+	t.Helper()
+	if timeout == 0 {
+		return fakeExtensionTx{}
+	}
+	bz, err := TimeoutTimestampExtensionOption{Timestamp: timeout}.Marshal()
+	if err != nil {
+		t.Fatalf("failed to marshal extension option: %v", err)
+	}
+	return fakeExtensionTx{extOpts: []*codectypes.Any{{
+		TypeUrl: timeoutTimestampExtensionTypeURL,
+		Value:   bz,
+	}}}
+}
+
+func TestTimeoutTimestampDecorator(t *testing.T) {
+	decorator := NewTimeoutTimestampDecorator()
+	blockTime := time.Now()
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Time: blockTime})
+
+	t.Run("no deadline set", func(t *testing.T) {
+		tx := newFakeExtensionTx(t, 0)
+		if _, err := decorator.AnteHandle(ctx, tx, false, noopNext); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("deadline in the future", func(t *testing.T) {
+		tx := newFakeExtensionTx(t, blockTime.Add(time.Hour).UnixNano())
+		if _, err := decorator.AnteHandle(ctx, tx, false, noopNext); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("deadline already elapsed", func(t *testing.T) {
+		tx := newFakeExtensionTx(t, blockTime.Add(-time.Hour).UnixNano())
+		if _, err := decorator.AnteHandle(ctx, tx, false, noopNext); err == nil {
+			t.Fatal("expected an error for an elapsed timeout-timestamp")
+		}
+	})
+}
+
+func TestTimeoutTimestampExtensionOptionRoundTrip(t *testing.T) {
+	want := int64(1234567890)
+	bz, err := TimeoutTimestampExtensionOption{Timestamp: want}.Marshal()
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+
+	var got TimeoutTimestampExtensionOption
+	if err := got.Unmarshal(bz); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	if got.Timestamp != want {
+		t.Fatalf("expected %d, got %d", want, got.Timestamp)
+	}
+}
@@ -1,12 +1,19 @@
 package cmd
 
 import (
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"time"
 
+	anteutil "github.com/celestiaorg/celestia-app/app/ante"
 	"github.com/celestiaorg/celestia-app/node"
 	qgbcmd "github.com/celestiaorg/celestia-app/x/qgb/client"
 
+	kitlog "github.com/go-kit/kit/log"
+	"gopkg.in/natefinch/lumberjack.v2"
+
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/app/encoding"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -20,18 +27,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/server"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -39,6 +53,33 @@ const (
 
 	// FlagLogToFile specifies whether to log to file or not.
 	FlagLogToFile = "log-to-file"
+
+	// FlagLogRotateFormat selects the encoding used when logging to file:
+	// "plain" (the default, logfmt-style) or "json".
+	FlagLogRotateFormat = "log-format-file"
+	// FlagLogMaxSize is the maximum size in megabytes of a log file before
+	// it gets rotated.
+	FlagLogMaxSize = "log-max-size"
+	// FlagLogMaxAge is the maximum number of days to retain old log files,
+	// based on the timestamp encoded in their filename.
+	FlagLogMaxAge = "log-max-age"
+	// FlagLogMaxBackups is the maximum number of old log files to retain.
+	FlagLogMaxBackups = "log-max-backups"
+
+	logFormatJSON = "json"
+
+	// flagTip is the coin a tipper offers a fee-payer in exchange for
+	// broadcasting their transaction. See auxSignCommand.
+	flagTip = "tip"
+
+	// FlagTimeoutTimestamp sets a wall-clock deadline (unix nanos) on a tx,
+	// as an alternative to timeout-height for users who can't reliably
+	// estimate block height under variable block times. checkTimeoutTimestampFlag
+	// rejects an already-past value before the tx is built. Only
+	// broadcast-tipped attaches it to the tx, as an extension option that
+	// app/ante's TimeoutTimestampDecorator checks against the block time;
+	// there is no mempool eviction of expired txs.
+	FlagTimeoutTimestamp = "timeout-timestamp"
 )
 
 // NewRootCmd creates a new root command for celestia-appd. It is called once in the
@@ -70,6 +111,11 @@ func NewRootCmd() *cobra.Command {
 				return err
 			}
 
+			initClientCtx, err = setGRPCClientFromClientConfig(initClientCtx)
+			if err != nil {
+				return err
+			}
+
 			if err := client.SetCmdClientContextHandler(initClientCtx, cmd); err != nil {
 				return err
 			}
@@ -94,12 +140,21 @@ func NewRootCmd() *cobra.Command {
 				}
 			}
 
+			// setDefaultConsensusParams seeds the genesis-time defaults used
+			// before a chain exists to govern anything; it's orthogonal to
+			// x/qgb's gov-gated MsgUpdateParams/ConsensusParamsChangeProposal
+			// path (see x/qgb/handler.go and x/qgb/keeper/proposal_handler.go),
+			// which only ever applies once the chain is already running.
 			return setDefaultConsensusParams(cmd)
 		},
 		SilenceUsage: true,
 	}
 
 	rootCmd.PersistentFlags().String(FlagLogToFile, "", "Write logs directly to a file. If empty, logs are written to stderr")
+	rootCmd.PersistentFlags().String(FlagLogRotateFormat, "plain", "Format used when logging to file: plain or json")
+	rootCmd.PersistentFlags().Int(FlagLogMaxSize, 100, "Maximum size in megabytes of a log file before it gets rotated")
+	rootCmd.PersistentFlags().Int(FlagLogMaxAge, 0, "Maximum number of days to retain old log files, 0 disables age-based cleanup")
+	rootCmd.PersistentFlags().Int(FlagLogMaxBackups, 0, "Maximum number of old log files to retain, 0 retains all")
 	initRootCmd(rootCmd, encodingConfig)
 
 	return rootCmd
@@ -120,7 +175,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig encoding.Config) {
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		debugCmd,
-		config.Cmd(),
+		configCmd(),
 		commands.CompactGoLevelDBCmd,
 	)
 
@@ -171,6 +226,9 @@ func txCommand() *cobra.Command {
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			return checkTimeoutTimestampFlag(cmd)
+		},
 	}
 
 	cmd.AddCommand(
@@ -182,11 +240,157 @@ func txCommand() *cobra.Command {
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
+		flags.LineBreak,
+		auxSignCommand(),
+		broadcastTippedCommand(),
 	)
 
 	app.ModuleBasics.AddTxCommands(cmd)
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.PersistentFlags().Uint64(FlagTimeoutTimestamp, 0, "Unix nanosecond timestamp after which the tx is no longer valid; 0 disables it")
+
+	return cmd
+}
+
+// checkTimeoutTimestampFlag rejects the command outright if the caller set a
+// timeout-timestamp that has already elapsed, so an already-expired tx isn't
+// built and broadcast only to be rejected later.
+func checkTimeoutTimestampFlag(cmd *cobra.Command) error {
+	if !cmd.Flags().Changed(FlagTimeoutTimestamp) {
+		return nil
+	}
+
+	timeoutTimestamp, err := cmd.Flags().GetUint64(FlagTimeoutTimestamp)
+	if err != nil {
+		return err
+	}
+	if timeoutTimestamp != 0 && int64(timeoutTimestamp) < time.Now().UnixNano() {
+		return fmt.Errorf("%s %d is already in the past", FlagTimeoutTimestamp, timeoutTimestamp)
+	}
+	return nil
+}
+
+// auxSignCommand returns the `tx aux-sign` command. It lets a tipper sign
+// only over body_bytes and the tip amount, emitting an AuxSignerData blob
+// that a fee-payer (e.g. a relayer) can later combine with their own
+// signature in broadcast-tipped. This allows a tipper to submit a blob
+// transaction without holding any utia to pay gas directly.
+func auxSignCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aux-sign [file]",
+		Short: "Sign a tx as the tipper, producing an AuxSignerData blob for a fee-payer to broadcast",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := clienttx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			auxBuilder := clienttx.NewAuxTxBuilder()
+			auxBuilder.SetAddress(clientCtx.GetFromAddress().String())
+			auxBuilder.SetAccountNumber(txf.AccountNumber())
+			auxBuilder.SetSequence(txf.Sequence())
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			if err := auxBuilder.SetMsgs(stdTx.GetMsgs()...); err != nil {
+				return err
+			}
+
+			tip, err := cmd.Flags().GetString(flagTip)
+			if err != nil {
+				return err
+			}
+			tipCoin, err := sdk.ParseCoinNormalized(tip)
+			if err != nil {
+				return err
+			}
+			auxBuilder.SetTip(tipCoin)
+
+			if err := txf.SignAux(cmd.Context(), clientCtx.GetFromName(), auxBuilder); err != nil {
+				return err
+			}
 
+			auxData := auxBuilder.GetAuxSignerData()
+			return clientCtx.PrintProto(&auxData)
+		},
+	}
+
+	cmd.Flags().String(flagTip, "", "Coin the tipper offers the fee-payer, e.g. 100utia")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// broadcastTippedCommand returns the `tx broadcast-tipped` command. It
+// combines an AuxSignerData blob produced by aux-sign with the fee-payer's
+// own signature and fee, then broadcasts the resulting tx.
+func broadcastTippedCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast-tipped [aux-signer-data-file] [tx-file]",
+		Short: "Assemble a tipped transaction from an AuxSignerData blob and broadcast it as the fee-payer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auxData, err := authclient.ReadAuxSignerDataFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			txf := clienttx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTipper(auxData.Address)
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+			if err != nil {
+				return err
+			}
+			if err := authclient.AddAuxSignerData(txBuilder, auxData); err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(FlagTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				extBuilder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+				if !ok {
+					return fmt.Errorf("tx builder does not support extension options, cannot set %s", FlagTimeoutTimestamp)
+				}
+				if err := anteutil.SetTimeoutTimestampExtensionOption(extBuilder, int64(timeoutTimestamp)); err != nil {
+					return err
+				}
+			}
+
+			if err := clienttx.Sign(cmd.Context(), txf, clientCtx.GetFromName(), txBuilder, true); err != nil {
+				return err
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -210,8 +414,98 @@ func createAppAndExport(
 	return capp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 }
 
+// grpcClientConfig mirrors the SDK's client.toml sections: it is the [grpc]
+// block that lets query subcommands reuse a single pre-dialed gRPC
+// connection instead of reopening one per call.
+type grpcClientConfig struct {
+	Address     string `mapstructure:"address"`
+	Insecure    bool   `mapstructure:"insecure"`
+	TLSCertPath string `mapstructure:"tls-cert-path"`
+}
+
+// setGRPCClientFromClientConfig reads the [grpc] section of client.toml (if
+// present) and, when an address is configured, dials it and attaches the
+// resulting *grpc.ClientConn to clientCtx so query subcommands don't have to
+// reopen a connection per call.
+func setGRPCClientFromClientConfig(clientCtx client.Context) (client.Context, error) {
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(clientCtx.HomeDir, "config", "client.toml"))
+	if err := v.ReadInConfig(); err != nil {
+		if os.IsNotExist(err) {
+			return clientCtx, nil
+		}
+		return clientCtx, err
+	}
+
+	var cfg grpcClientConfig
+	if err := v.UnmarshalKey("grpc", &cfg); err != nil {
+		return clientCtx, err
+	}
+	if cfg.Address == "" {
+		return clientCtx, nil
+	}
+
+	// Dial in plaintext unless a TLS cert was actually configured: an
+	// address written by `config grpc <addr>` with no `insecure`/
+	// `tls-cert-path` set shouldn't make every other command fail trying to
+	// load a TLS cert from an empty path.
+	var creds credentials.TransportCredentials
+	if cfg.Insecure || cfg.TLSCertPath == "" {
+		creds = insecure.NewCredentials()
+	} else {
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(cfg.TLSCertPath, "")
+		if err != nil {
+			return clientCtx, err
+		}
+	}
+
+	conn, err := grpc.Dial(cfg.Address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return clientCtx, err
+	}
+
+	return clientCtx.WithGRPCClient(conn), nil
+}
+
+// configCmd wraps the SDK's config.Cmd() with a `grpc` helper subcommand for
+// editing the [grpc] address in client.toml.
+func configCmd() *cobra.Command {
+	cmd := config.Cmd()
+	cmd.AddCommand(configGRPCCmd())
+	return cmd
+}
+
+// configGRPCCmd returns the `celestia-appd config grpc <addr>` helper, which
+// edits the [grpc] address in client.toml alongside the rest of the values
+// config.Cmd() manages.
+func configGRPCCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "grpc [address]",
+		Short: "Set the gRPC client address used by query subcommands",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			configPath := filepath.Join(clientCtx.HomeDir, "config", "client.toml")
+			v := viper.New()
+			v.SetConfigFile(configPath)
+			if err := v.ReadInConfig(); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+
+			v.Set("grpc.address", args[0])
+			return v.WriteConfigAs(configPath)
+		},
+	}
+}
+
 // replaceLogger optionally replaces the logger with a file logger if the flag
-// is set to something other than the default.
+// is set to something other than the default. The file sink rotates by size
+// and age via lumberjack and, with --log-format-file=json, emits one JSON
+// object per line with ts/level/module/height fields instead of the default
+// logfmt-style output. Either way, logs are fanned out to both the file and
+// stderr so operators don't lose the console stream they're used to.
 func replaceLogger(cmd *cobra.Command) error {
 	logFilePath, err := cmd.Flags().GetString(FlagLogToFile)
 	if err != nil {
@@ -222,12 +516,63 @@ func replaceLogger(cmd *cobra.Command) error {
 		return nil
 	}
 
-	file, err := os.OpenFile(logFilePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	maxSize, err := cmd.Flags().GetInt(FlagLogMaxSize)
+	if err != nil {
+		return err
+	}
+	maxAge, err := cmd.Flags().GetInt(FlagLogMaxAge)
+	if err != nil {
+		return err
+	}
+	maxBackups, err := cmd.Flags().GetInt(FlagLogMaxBackups)
+	if err != nil {
+		return err
+	}
+	logFormat, err := cmd.Flags().GetString(FlagLogRotateFormat)
 	if err != nil {
 		return err
 	}
 
+	fileWriter := &lumberjack.Logger{
+		Filename:   logFilePath,
+		MaxSize:    maxSize,
+		MaxAge:     maxAge,
+		MaxBackups: maxBackups,
+	}
+	writer := io.MultiWriter(fileWriter, os.Stderr)
+
+	var logger log.Logger
+	switch logFormat {
+	case logFormatJSON:
+		logger = newJSONLogger(log.NewSyncWriter(writer))
+	default:
+		logger = log.NewTMLogger(log.NewSyncWriter(writer))
+	}
+
 	sctx := server.GetServerContextFromCmd(cmd)
-	sctx.Logger = log.NewTMLogger(log.NewSyncWriter(file))
+	sctx.Logger = logger
 	return server.SetCmdServerContext(cmd, sctx)
 }
+
+// jsonLogger is a log.Logger that emits one JSON object per line with
+// ts/level/msg plus whatever module/height context has been attached via
+// With, instead of the default logfmt-style output.
+type jsonLogger struct {
+	kit kitlog.Logger
+}
+
+func newJSONLogger(w io.Writer) log.Logger {
+	return &jsonLogger{kit: kitlog.With(kitlog.NewJSONLogger(w), "ts", kitlog.DefaultTimestampUTC)}
+}
+
+func (l *jsonLogger) Debug(msg string, keyvals ...interface{}) { l.log("debug", msg, keyvals...) }
+func (l *jsonLogger) Info(msg string, keyvals ...interface{})  { l.log("info", msg, keyvals...) }
+func (l *jsonLogger) Error(msg string, keyvals ...interface{}) { l.log("error", msg, keyvals...) }
+
+func (l *jsonLogger) log(level, msg string, keyvals ...interface{}) {
+	_ = l.kit.Log(append([]interface{}{"level", level, "msg", msg}, keyvals...)...)
+}
+
+func (l *jsonLogger) With(keyvals ...interface{}) log.Logger {
+	return &jsonLogger{kit: kitlog.With(l.kit, keyvals...)}
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func TestCheckTimeoutTimestampFlag(t *testing.T) {
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{Use: "tx"}
+		cmd.Flags().Uint64(FlagTimeoutTimestamp, 0, "")
+		return cmd
+	}
+
+	t.Run("flag not set", func(t *testing.T) {
+		cmd := newCmd()
+		if err := checkTimeoutTimestampFlag(cmd); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("future timestamp", func(t *testing.T) {
+		cmd := newCmd()
+		future := uint64(time.Now().Add(time.Hour).UnixNano())
+		if err := cmd.Flags().Set(FlagTimeoutTimestamp, strconv.FormatUint(future, 10)); err != nil {
+			t.Fatalf("failed to set flag: %v", err)
+		}
+		if err := checkTimeoutTimestampFlag(cmd); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("past timestamp", func(t *testing.T) {
+		cmd := newCmd()
+		past := uint64(time.Now().Add(-time.Hour).UnixNano())
+		if err := cmd.Flags().Set(FlagTimeoutTimestamp, strconv.FormatUint(past, 10)); err != nil {
+			t.Fatalf("failed to set flag: %v", err)
+		}
+		if err := checkTimeoutTimestampFlag(cmd); err == nil {
+			t.Fatal("expected an error for a timestamp in the past, got nil")
+		}
+	})
+}
+
+func TestGRPCClientConfigRoundTrip(t *testing.T) {
+	home := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(home, "config"), 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	cmd := configGRPCCmd()
+	cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &client.Context{}))
+	if err := client.SetCmdClientContext(cmd, client.Context{HomeDir: home}); err != nil {
+		t.Fatalf("failed to set client context: %v", err)
+	}
+
+	if err := cmd.RunE(cmd, []string{"localhost:9090"}); err != nil {
+		t.Fatalf("configGRPCCmd failed: %v", err)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(home, "config", "client.toml"))
+	if err := v.ReadInConfig(); err != nil {
+		t.Fatalf("failed to read back client.toml: %v", err)
+	}
+
+	var cfg grpcClientConfig
+	if err := v.UnmarshalKey("grpc", &cfg); err != nil {
+		t.Fatalf("failed to unmarshal grpc config: %v", err)
+	}
+	if cfg.Address != "localhost:9090" {
+		t.Fatalf("expected address %q, got %q", "localhost:9090", cfg.Address)
+	}
+}
+
+// TestSetGRPCClientFromClientConfigAfterHelper exercises the exact path a
+// user hits after running `celestia-appd config grpc <addr>`: the helper
+// only ever writes grpc.address, so setGRPCClientFromClientConfig must not
+// require insecure/tls-cert-path to also be set by hand.
+func TestSetGRPCClientFromClientConfigAfterHelper(t *testing.T) {
+	home := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(home, "config"), 0o755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	cmd := configGRPCCmd()
+	cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &client.Context{}))
+	if err := client.SetCmdClientContext(cmd, client.Context{HomeDir: home}); err != nil {
+		t.Fatalf("failed to set client context: %v", err)
+	}
+	if err := cmd.RunE(cmd, []string{"localhost:9090"}); err != nil {
+		t.Fatalf("configGRPCCmd failed: %v", err)
+	}
+
+	clientCtx, err := setGRPCClientFromClientConfig(client.Context{HomeDir: home})
+	if err != nil {
+		t.Fatalf("expected no error dialing with defaults from the config helper, got %v", err)
+	}
+	if clientCtx.GRPCClient == nil {
+		t.Fatal("expected a GRPCClient to be attached to the client context")
+	}
+}
+
+func TestJSONLoggerOutput(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newJSONLogger(&buf)
+
+	logger = logger.With("module", "test")
+	logger.Info("hello", "height", 42)
+
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("expected valid JSON output, got %q: %v", buf.String(), err)
+	}
+
+	for k, want := range map[string]interface{}{
+		"level":  "info",
+		"msg":    "hello",
+		"module": "test",
+		"height": float64(42),
+	} {
+		if got := line[k]; got != want {
+			t.Fatalf("expected %s=%v, got %v", k, want, got)
+		}
+	}
+	if _, ok := line["ts"]; !ok {
+		t.Fatal("expected a ts field in the log output")
+	}
+}
@@ -37,6 +37,12 @@ type Config struct {
 	AppCreator srvtypes.AppCreator
 	// SupressLogs
 	SupressLogs bool
+	// MockConsensus, when true, lets NewMockConsensusDriverFromConfig build
+	// an in-process MockConsensusDriver that produces blocks on demand rather
+	// than on wall-clock timeouts. The testnode's own startup does not read
+	// this field and still starts a real Tendermint node. See
+	// WithMockConsensus.
+	MockConsensus bool
 }
 
 // WithChainID sets the ChainID and returns the Config.
@@ -107,6 +113,20 @@ func (c *Config) WithTimeoutCommit(d time.Duration) *Config {
 	return c
 }
 
+// WithMockConsensus sets MockConsensus and returns the Config.
+//
+// When enabled, NewMockConsensusDriverFromConfig accepts the Config and
+// returns a MockConsensusDriver for it; the testnode's own startup is not
+// changed by this option and still starts a real Tendermint node. The driver
+// drives the app through the normal BeginBlock/DeliverTx/EndBlock/Commit ABCI
+// lifecycle, but blocks are only produced when the test explicitly asks for
+// one, so a test driving the app through it does not wait on
+// TimeoutCommit/TimeoutPropose.
+func (c *Config) WithMockConsensus(enabled bool) *Config {
+	c.MockConsensus = enabled
+	return c
+}
+
 func DefaultConfig() *Config {
 	tmcfg := DefaultTendermintConfig()
 	tmcfg.Consensus.TimeoutCommit = 1 * time.Millisecond
@@ -0,0 +1,109 @@
+package testnode
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+// MockConsensusDriver drives an ABCI application through the
+// BeginBlock/DeliverTx/EndBlock/Commit lifecycle on demand, instead of on the
+// wall-clock cadence a real Tendermint node would use. A test builds one with
+// NewMockConsensusDriver, or with NewMockConsensusDriverFromConfig from a
+// Config created with WithMockConsensus(true). It is modeled on the
+// CometMock approach used by interchain-security tests: blocks only advance
+// when a test calls AdvanceBlocks, so QGB nonce bumps and validator set
+// changes can be exercised without waiting on TimeoutCommit/TimeoutPropose.
+type MockConsensusDriver struct {
+	app     abci.Application
+	chainID string
+
+	height    int64
+	blockTime time.Time
+	valSet    []abci.ValidatorUpdate
+
+	// pending maps a future block height to the txs that must be included
+	// in that block. Keys are absolute block heights, queued up ahead of
+	// time via InjectTx.
+	pending map[int64][][]byte
+}
+
+// NewMockConsensusDriver returns a MockConsensusDriver wrapping app. The
+// first block it produces is at height 1, with the given genesis time;
+// Height reports 0 until then.
+func NewMockConsensusDriver(app abci.Application, chainID string, genesisTime time.Time) *MockConsensusDriver {
+	return &MockConsensusDriver{
+		app:       app,
+		chainID:   chainID,
+		height:    1,
+		blockTime: genesisTime,
+		pending:   make(map[int64][][]byte),
+	}
+}
+
+// InjectTx queues tx to be delivered in the given block height. It must be
+// called before AdvanceBlocks reaches that height.
+func (d *MockConsensusDriver) InjectTx(height int64, tx []byte) {
+	d.pending[height] = append(d.pending[height], tx)
+}
+
+// SetBlockTime overrides the timestamp used for the next block produced by
+// AdvanceBlocks.
+func (d *MockConsensusDriver) SetBlockTime(t time.Time) {
+	d.blockTime = t
+}
+
+// AdvanceBlocks synchronously drives n blocks through the app's
+// BeginBlock/DeliverTx/EndBlock/Commit path, delivering any txs queued for
+// each height via InjectTx. It returns the height of the last block produced.
+func (d *MockConsensusDriver) AdvanceBlocks(n int) int64 {
+	for i := 0; i < n; i++ {
+		d.app.BeginBlock(abci.RequestBeginBlock{
+			Header: tmproto.Header{
+				ChainID: d.chainID,
+				Height:  d.height,
+				Time:    d.blockTime,
+			},
+		})
+
+		for _, tx := range d.pending[d.height] {
+			d.app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		}
+		delete(d.pending, d.height)
+
+		endBlock := d.app.EndBlock(abci.RequestEndBlock{Height: d.height})
+		if len(endBlock.ValidatorUpdates) > 0 {
+			d.valSet = endBlock.ValidatorUpdates
+		}
+
+		d.app.Commit()
+
+		d.height++
+		d.blockTime = d.blockTime.Add(time.Duration(types.DefaultConsensusParams().Block.TimeIotaMs) * time.Millisecond)
+	}
+	return d.height - 1
+}
+
+// Height returns the last committed height.
+func (d *MockConsensusDriver) Height() int64 {
+	return d.height - 1
+}
+
+// ValidatorSet returns the most recently applied validator set updates.
+func (d *MockConsensusDriver) ValidatorSet() []abci.ValidatorUpdate {
+	return d.valSet
+}
+
+// NewMockConsensusDriverFromConfig builds a MockConsensusDriver for cfg,
+// using cfg.ChainID and the current time
+// as the genesis time. It panics if cfg was not built with
+// WithMockConsensus(true), since callers must opt into this path explicitly
+// rather than get it implicitly.
+func NewMockConsensusDriverFromConfig(cfg *Config, app abci.Application) *MockConsensusDriver {
+	if !cfg.MockConsensus {
+		panic("testnode: NewMockConsensusDriverFromConfig called without WithMockConsensus(true)")
+	}
+	return NewMockConsensusDriver(app, cfg.ChainID, time.Now())
+}
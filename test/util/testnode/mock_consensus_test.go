@@ -0,0 +1,43 @@
+package testnode
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestMockConsensusDriverAdvanceBlocks(t *testing.T) {
+	driver := NewMockConsensusDriver(abci.BaseApplication{}, "test-chain", time.Now())
+
+	height := driver.AdvanceBlocks(3)
+
+	if height != 3 {
+		t.Fatalf("expected height 3, got %d", height)
+	}
+	if driver.Height() != 3 {
+		t.Fatalf("expected Height() 3, got %d", driver.Height())
+	}
+}
+
+func TestNewMockConsensusDriverFromConfig(t *testing.T) {
+	app := abci.BaseApplication{}
+
+	t.Run("mock consensus enabled", func(t *testing.T) {
+		cfg := DefaultConfig().WithMockConsensus(true)
+		driver := NewMockConsensusDriverFromConfig(cfg, app)
+		if driver == nil {
+			t.Fatal("expected a non-nil driver")
+		}
+	})
+
+	t.Run("mock consensus disabled", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected a panic when MockConsensus is false")
+			}
+		}()
+		cfg := DefaultConfig().WithMockConsensus(false)
+		NewMockConsensusDriverFromConfig(cfg, app)
+	})
+}
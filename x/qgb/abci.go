@@ -0,0 +1,28 @@
+package qgb
+
+import (
+	"github.com/celestiaorg/celestia-app/x/qgb/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// BeginBlocker runs at the start of every block. It returns the consensus
+// params scheduled by a passed ConsensusParamsChangeProposal, or nil if none
+// is pending. The app's BeginBlock (outside this module) is responsible for
+// folding a non-nil result into its ResponseBeginBlock/consensus engine
+// update, the same way other gov-triggered param changes are applied.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) *tmproto.ConsensusParams {
+	return k.ConsumePendingConsensusParamsUpdate(ctx)
+}
+
+// EndBlocker runs at the end of every block. It refuses to let the chain
+// continue producing attestations if the ExpectedNextAttestation head was
+// never initialized (missing genesis or migration wiring), and otherwise
+// leaves attestation creation to the module's existing nonce/window logic:
+// any attestation it hands to SetAttestationRequest is rejected unless its
+// nonce and predecessor hash match the stored head.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if !k.CheckExpectedNextAttestation(ctx) {
+		panic("qgb: ExpectedNextAttestation head not initialized; check InitGenesis/MigrateExpectedNextAttestationHead wiring")
+	}
+}
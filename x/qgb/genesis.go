@@ -0,0 +1,25 @@
+package qgb
+
+import (
+	"github.com/celestiaorg/celestia-app/x/qgb/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the ExpectedNextAttestation head for the x/qgb
+// module. On a fresh chain it seeds the head at nonce=1 from
+// genesisValsetHash. On a chain restarting from an exported genesis that
+// already carried attestations forward but predates the head record, it
+// rebuilds the head from the latest stored attestation instead, via
+// MigrateExpectedNextAttestationHead.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genesisValsetHash []byte) {
+	switch {
+	case k.CheckExpectedNextAttestation(ctx):
+		// already set, e.g. by a previous call on this store.
+	case k.CheckLatestAttestationNonce(ctx):
+		if err := k.MigrateExpectedNextAttestationHead(ctx); err != nil {
+			panic(err)
+		}
+	default:
+		k.InitExpectedNextAttestation(ctx, genesisValsetHash)
+	}
+}
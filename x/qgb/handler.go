@@ -0,0 +1,28 @@
+package qgb
+
+import (
+	"github.com/celestiaorg/celestia-app/x/qgb/keeper"
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns the legacy sdk.Handler for x/qgb messages, routing
+// MsgUpdateParams to Keeper.UpdateParams. MsgUpdateParams is gov-authority
+// gated, so in practice it only ever reaches this handler wrapped in a
+// passed governance proposal, never submitted directly by a user.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgUpdateParams:
+			if err := k.UpdateParams(ctx, msg.Authority, msg.Params); err != nil {
+				return nil, err
+			}
+			return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
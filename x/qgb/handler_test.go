@@ -0,0 +1,28 @@
+package qgb
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/x/qgb/keeper"
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHandlerRejectsUnrecognizedMessage(t *testing.T) {
+	h := NewHandler(keeper.Keeper{})
+
+	if _, err := h(sdk.Context{}, sdk.NewTestMsg()); err == nil {
+		t.Fatal("expected an error for an unrecognized message type")
+	}
+}
+
+func TestHandlerMsgUpdateParamsRejectsWrongAuthority(t *testing.T) {
+	h := NewHandler(keeper.Keeper{})
+
+	addr := sdk.AccAddress(make([]byte, 20)).String()
+	msg := types.NewMsgUpdateParams(addr, types.DefaultParams())
+
+	if _, err := h(sdk.Context{}, msg); err == nil {
+		t.Fatal("expected an error for a MsgUpdateParams authority that doesn't match the keeper's configured authority")
+	}
+}
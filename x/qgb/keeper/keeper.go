@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper maintains the state of the qgb module, including the attestation
+// store, the latest/last-pruned/last-unbonding nonces, the
+// ExpectedNextAttestation head, and the module's governable params.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+
+	paramSpace paramtypes.Subspace
+	// authority is the gov module account address allowed to submit
+	// MsgUpdateParams for this module.
+	authority string
+}
+
+// NewKeeper returns a new instance of the qgb keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	authority string,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+		authority:  authority,
+	}
+}
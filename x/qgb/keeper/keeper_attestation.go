@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 
 	"github.com/celestiaorg/celestia-app/x/qgb/types"
@@ -8,10 +10,49 @@ import (
 )
 
 // SetAttestationRequest sets a new attestation request to the store to be signed
-// by orchestrators afterwards.
+// by orchestrators afterwards. It refuses to persist an attestation whose
+// nonce or predecessor hash doesn't match the stored ExpectedNextAttestation
+// head, closing the class of bugs where a partially-pruned or
+// partially-migrated store yields a "phantom" nonce gap.
 func (k Keeper) SetAttestationRequest(ctx sdk.Context, at types.AttestationRequestI) error {
+	head := k.GetExpectedNextAttestation(ctx)
+	if head.Nonce != at.GetNonce() {
+		return fmt.Errorf("attestation nonce %d does not match expected next attestation nonce %d", at.GetNonce(), head.Nonce)
+	}
+
+	// head.Hash is the hash the module recorded for the attestation at
+	// head.Nonce-1 when it was stored. Recompute it from what's actually in
+	// store and compare, so a desynced/corrupted head can't silently let a
+	// wrong predecessor through the nonce check above.
+	if head.Nonce > 1 {
+		prev, found, err := k.GetAttestationByNonce(ctx, head.Nonce-1)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("predecessor attestation %d not found in store", head.Nonce-1)
+		}
+		prevHash, err := k.hashAttestation(prev)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(prevHash, head.Hash) {
+			return fmt.Errorf("expected next attestation head hash %x does not match the hash of attestation %d", head.Hash, head.Nonce-1)
+		}
+	}
+
+	hash, err := k.hashAttestation(at)
+	if err != nil {
+		return err
+	}
+
 	k.StoreAttestation(ctx, at)
 	k.SetLatestAttestationNonce(ctx, at.GetNonce())
+	k.SetExpectedNextAttestation(ctx, types.ExpectedNextAttestation{
+		Nonce:  at.GetNonce() + 1,
+		Height: uint64(ctx.BlockHeight()),
+		Hash:   hash,
+	})
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -23,6 +64,98 @@ func (k Keeper) SetAttestationRequest(ctx sdk.Context, at types.AttestationReque
 	return nil
 }
 
+// hashAttestation returns the hash of at's marshaled bytes, used as the
+// predecessor hash recorded in ExpectedNextAttestation. It is computed here
+// rather than via a method on AttestationRequestI so that no change is
+// required to that interface's existing implementations.
+func (k Keeper) hashAttestation(at types.AttestationRequestI) ([]byte, error) {
+	bz, err := k.cdc.MarshalInterface(at)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(bz)
+	return sum[:], nil
+}
+
+// InitExpectedNextAttestation sets the genesis ExpectedNextAttestation head:
+// nonce=1, height=1, and hash set to the hash of the genesis validator set.
+// It must be called once, from InitGenesis.
+func (k Keeper) InitExpectedNextAttestation(ctx sdk.Context, genesisValsetHash []byte) {
+	k.SetExpectedNextAttestation(ctx, types.ExpectedNextAttestation{
+		Nonce:  1,
+		Height: 1,
+		Hash:   genesisValsetHash,
+	})
+}
+
+// CheckExpectedNextAttestation returns true if the ExpectedNextAttestation
+// head has been initialized in store, and false if not.
+func (k Keeper) CheckExpectedNextAttestation(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has([]byte(types.ExpectedNextAttestationNonceKey))
+}
+
+// GetExpectedNextAttestation returns the ExpectedNextAttestation head.
+// Panics if it hasn't been initialized; call CheckExpectedNextAttestation
+// first, or run MigrateExpectedNextAttestationHead on a store that predates
+// this record.
+func (k Keeper) GetExpectedNextAttestation(ctx sdk.Context) types.ExpectedNextAttestation {
+	store := ctx.KVStore(k.storeKey)
+
+	nonceBz := store.Get([]byte(types.ExpectedNextAttestationNonceKey))
+	if nonceBz == nil {
+		panic("nil ExpectedNextAttestation head")
+	}
+	heightBz := store.Get([]byte(types.ExpectedNextAttestationHeightKey))
+	hash := store.Get([]byte(types.ExpectedNextAttestationHashKey))
+
+	return types.ExpectedNextAttestation{
+		Nonce:  UInt64FromBytes(nonceBz),
+		Height: UInt64FromBytes(heightBz),
+		Hash:   hash,
+	}
+}
+
+// SetExpectedNextAttestation sets the ExpectedNextAttestation head.
+func (k Keeper) SetExpectedNextAttestation(ctx sdk.Context, head types.ExpectedNextAttestation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.ExpectedNextAttestationNonceKey), types.UInt64Bytes(head.Nonce))
+	store.Set([]byte(types.ExpectedNextAttestationHeightKey), types.UInt64Bytes(head.Height))
+	store.Set([]byte(types.ExpectedNextAttestationHashKey), head.Hash)
+}
+
+// MigrateExpectedNextAttestationHead rebuilds the ExpectedNextAttestation
+// head from the latest stored attestation. It is state-sync-safe: a node
+// that restores from a snapshot or an export taken before this record existed
+// can call it on startup to derive a consistent head rather than starting
+// with a "phantom" nonce gap. It is a no-op if the head is already present.
+func (k Keeper) MigrateExpectedNextAttestationHead(ctx sdk.Context) error {
+	if k.CheckExpectedNextAttestation(ctx) {
+		return nil
+	}
+
+	latestNonce := k.GetLatestAttestationNonce(ctx)
+	latest, found, err := k.GetAttestationByNonce(ctx, latestNonce)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("cannot rebuild ExpectedNextAttestation head: latest attestation nonce %d not found in store", latestNonce)
+	}
+
+	hash, err := k.hashAttestation(latest)
+	if err != nil {
+		return err
+	}
+
+	k.SetExpectedNextAttestation(ctx, types.ExpectedNextAttestation{
+		Nonce:  latestNonce + 1,
+		Height: uint64(ctx.BlockHeight()),
+		Hash:   hash,
+	})
+	return nil
+}
+
 // StoreAttestation saves the attestation in store.
 // Should panic if overwriting existing one.
 func (k Keeper) StoreAttestation(ctx sdk.Context, at types.AttestationRequestI) {
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// fakeAttestation is a minimal types.AttestationRequestI used to exercise
+// SetAttestationRequest's nonce check without depending on any of the real
+// attestation types (Valset, DataCommitment).
+type fakeAttestation struct {
+	nonce uint64
+}
+
+func (f *fakeAttestation) Reset()           {}
+func (f *fakeAttestation) String() string   { return "" }
+func (f *fakeAttestation) ProtoMessage()    {}
+func (f *fakeAttestation) GetNonce() uint64 { return f.nonce }
+
+var _ types.AttestationRequestI = (*fakeAttestation)(nil)
+
+func newTestKeeper(t *testing.T) (Keeper, sdk.Context) {
+	storeKey := sdk.NewKVStoreKey("qgb")
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	if err := cms.LoadLatestVersion(); err != nil {
+		t.Fatalf("failed to load store: %v", err)
+	}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	return Keeper{cdc: cdc, storeKey: storeKey}, ctx
+}
+
+func TestSetAttestationRequestRejectsNonceMismatch(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	k.InitExpectedNextAttestation(ctx, []byte("genesis-valset-hash"))
+
+	err := k.SetAttestationRequest(ctx, &fakeAttestation{nonce: 5})
+	if err == nil {
+		t.Fatal("expected an error for a mismatched nonce, got nil")
+	}
+	if k.CheckLatestAttestationNonce(ctx) {
+		t.Fatal("expected the rejected attestation not to be stored")
+	}
+
+	head := k.GetExpectedNextAttestation(ctx)
+	if head.Nonce != 1 {
+		t.Fatalf("expected the expected-next-attestation head to stay at 1, got %d", head.Nonce)
+	}
+}
+
+func TestSetAttestationRequestRejectsPredecessorHashMismatch(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	k.InitExpectedNextAttestation(ctx, []byte("genesis-valset-hash"))
+
+	if err := k.SetAttestationRequest(ctx, &fakeAttestation{nonce: 1}); err != nil {
+		t.Fatalf("failed to store attestation 1: %v", err)
+	}
+
+	// Corrupt the recorded head hash so it no longer matches attestation 1's
+	// actual hash, simulating a desynced/corrupted store.
+	head := k.GetExpectedNextAttestation(ctx)
+	head.Hash = []byte("corrupted-hash")
+	k.SetExpectedNextAttestation(ctx, head)
+
+	err := k.SetAttestationRequest(ctx, &fakeAttestation{nonce: 2})
+	if err == nil {
+		t.Fatal("expected an error for a mismatched predecessor hash, got nil")
+	}
+	if k.GetLatestAttestationNonce(ctx) != 1 {
+		t.Fatalf("expected the rejected attestation not to be stored, latest nonce is %d", k.GetLatestAttestationNonce(ctx))
+	}
+}
+
+func TestSetAttestationRequestRejectsWithoutExpectedNextAttestation(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	if k.CheckExpectedNextAttestation(ctx) {
+		t.Fatal("expected ExpectedNextAttestation to be uninitialized")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected SetAttestationRequest to panic without an initialized ExpectedNextAttestation head")
+		}
+	}()
+	_ = k.SetAttestationRequest(ctx, &fakeAttestation{nonce: 1})
+}
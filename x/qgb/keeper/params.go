@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetParams returns the current x/qgb parameters, which include the
+// data-commitment window and the unbonding-window guard. These used to be
+// baked into compile-time constants; they are now keeper-owned so they can be
+// tuned via a governance proposal without a hard fork.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the x/qgb parameters in the param store.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// DataCommitmentWindow returns the number of blocks between two data
+// commitment attestation requests, read from the live param rather than a
+// compile-time constant.
+func (k Keeper) DataCommitmentWindow(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).DataCommitmentWindow
+}
+
+// UnbondingWindow returns the number of blocks a validator must remain bonded
+// for after requesting to unbond, so that pending attestations can still be
+// signed.
+func (k Keeper) UnbondingWindow(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).UnbondingWindow
+}
+
+// UpdateParams applies a MsgUpdateParams-style update to the x/qgb params.
+// The caller must be the chain's governance authority address; this mirrors
+// the gov-guarded MsgUpdateParams pattern used elsewhere in the SDK.
+func (k Keeper) UpdateParams(ctx sdk.Context, authority string, params types.Params) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamsUpdated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+	return nil
+}
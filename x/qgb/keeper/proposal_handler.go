@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// pendingConsensusParamsKey stores a consensus params update scheduled by a
+// passed ConsensusParamsChangeProposal, to be applied and cleared on the next
+// BeginBlocker.
+const pendingConsensusParamsKey = "pendingConsensusParamsUpdate"
+
+// NewConsensusParamsChangeProposalHandler returns a gov proposal handler for
+// types.ConsensusParamsChangeProposal. Once the proposal passes, it schedules
+// the new Tendermint consensus params (block size, evidence age, etc.) to be
+// applied at the chain's next BeginBlocker via
+// ConsumePendingConsensusParamsUpdate.
+func NewConsensusParamsChangeProposalHandler(k Keeper) func(ctx sdk.Context, content *types.ConsensusParamsChangeProposal) error {
+	return func(ctx sdk.Context, content *types.ConsensusParamsChangeProposal) error {
+		if content == nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nil consensus params change proposal")
+		}
+		if err := content.ValidateBasic(); err != nil {
+			return err
+		}
+		k.SetPendingConsensusParamsUpdate(ctx, content.ConsensusParams)
+		return nil
+	}
+}
+
+// SetPendingConsensusParamsUpdate schedules params to be applied by the next
+// call to ConsumePendingConsensusParamsUpdate.
+func (k Keeper) SetPendingConsensusParamsUpdate(ctx sdk.Context, params *tmproto.ConsensusParams) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := params.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(pendingConsensusParamsKey), bz)
+}
+
+// ConsumePendingConsensusParamsUpdate returns the consensus params scheduled
+// by a passed ConsensusParamsChangeProposal, clearing it from the store, or
+// nil if none is pending. The caller (the app's BeginBlock, outside this
+// module) is responsible for applying the returned params to the running
+// consensus engine.
+func (k Keeper) ConsumePendingConsensusParamsUpdate(ctx sdk.Context) *tmproto.ConsensusParams {
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(pendingConsensusParamsKey)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return nil
+	}
+	store.Delete(key)
+
+	var params tmproto.ConsensusParams
+	if err := params.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return &params
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const (
+	// ProposalTypeConsensusParamsChange is the gov proposal type that
+	// schedules a Tendermint consensus params update (block size, evidence
+	// age, etc.) to be applied by x/qgb's BeginBlocker.
+	ProposalTypeConsensusParamsChange = "ConsensusParamsChange"
+)
+
+// ConsensusParamsChangeProposal is a gov.Content that, once it passes,
+// schedules consensusParams to be applied to the running chain at the next
+// BeginBlock. This mirrors the "governable consensus params" pattern so
+// networks can tune block size/evidence age without a hard fork.
+type ConsensusParamsChangeProposal struct {
+	Title           string
+	Description     string
+	ConsensusParams *tmproto.ConsensusParams
+}
+
+func (p *ConsensusParamsChangeProposal) GetTitle() string       { return p.Title }
+func (p *ConsensusParamsChangeProposal) GetDescription() string { return p.Description }
+func (p *ConsensusParamsChangeProposal) ProposalRoute() string  { return ModuleName }
+func (p *ConsensusParamsChangeProposal) ProposalType() string   { return ProposalTypeConsensusParamsChange }
+
+// ValidateBasic implements gov.Content.
+func (p *ConsensusParamsChangeProposal) ValidateBasic() error {
+	if p.ConsensusParams == nil {
+		return fmt.Errorf("consensus params change proposal must set consensus params")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer, as required by gov.Content.
+func (p *ConsensusParamsChangeProposal) String() string {
+	return fmt.Sprintf("Consensus Params Change Proposal:\n  Title: %s\n  Description: %s\n  ConsensusParams: %v\n", p.Title, p.Description, p.ConsensusParams)
+}
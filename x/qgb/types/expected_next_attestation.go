@@ -0,0 +1,25 @@
+package types
+
+const (
+	// ExpectedNextAttestationNonceKey is the store key for the nonce half of
+	// the ExpectedNextAttestation head.
+	ExpectedNextAttestationNonceKey = "expectedNextAttestationNonce"
+	// ExpectedNextAttestationHeightKey is the store key for the height half
+	// of the ExpectedNextAttestation head.
+	ExpectedNextAttestationHeightKey = "expectedNextAttestationHeight"
+	// ExpectedNextAttestationHashKey is the store key for the predecessor
+	// hash half of the ExpectedNextAttestation head.
+	ExpectedNextAttestationHashKey = "expectedNextAttestationHash"
+)
+
+// ExpectedNextAttestation is the "execution head" the module expects the
+// next attestation request to extend: the nonce it must carry, and the
+// height/hash of the attestation request it follows. SetAttestationRequest
+// refuses to persist an attestation whose nonce or predecessor hash don't
+// match this record, closing the class of bugs where a partially-pruned or
+// partially-migrated store yields a phantom nonce gap.
+type ExpectedNextAttestation struct {
+	Nonce  uint64
+	Height uint64
+	Hash   []byte
+}
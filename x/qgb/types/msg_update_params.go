@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TypeMsgUpdateParams is the type string for MsgUpdateParams.
+const TypeMsgUpdateParams = "update_params"
+
+// MsgUpdateParams is a gov-authority-gated message that updates the x/qgb
+// params (the data-commitment window and the unbonding-window guard).
+type MsgUpdateParams struct {
+	// Authority must be the chain's governance module account address.
+	Authority string
+	Params    Params
+}
+
+// NewMsgUpdateParams returns a new MsgUpdateParams.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{Authority: authority, Params: params}
+}
+
+func (m *MsgUpdateParams) Route() string { return ModuleName }
+func (m *MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+
+// ValidateBasic implements sdk.Msg.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+	return m.Params.Validate()
+}
+
+// GetSigners implements sdk.Msg.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements sdk.Msg.
+func (m *MsgUpdateParams) GetSignBytes() []byte {
+	bz, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
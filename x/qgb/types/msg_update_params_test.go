@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	validAuthority := sdk.AccAddress(make([]byte, 20)).String()
+
+	tests := []struct {
+		name    string
+		msg     *MsgUpdateParams
+		wantErr bool
+	}{
+		{"valid", NewMsgUpdateParams(validAuthority, DefaultParams()), false},
+		{"invalid authority", NewMsgUpdateParams("not-an-address", DefaultParams()), true},
+		{"invalid params", NewMsgUpdateParams(validAuthority, NewParams(0, 0)), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr && err == nil {
+				t.Fatalf("expected an error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// DefaultDataCommitmentWindow is the default number of blocks between
+	// two data commitment attestation requests.
+	DefaultDataCommitmentWindow = uint64(400)
+	// DefaultUnbondingWindow is the default number of blocks a validator
+	// must remain bonded for after requesting to unbond, so that pending
+	// attestations can still be signed.
+	DefaultUnbondingWindow = uint64(100000)
+)
+
+// Parameter store keys for the x/qgb params.
+var (
+	KeyDataCommitmentWindow = []byte("DataCommitmentWindow")
+	KeyUnbondingWindow      = []byte("UnbondingWindow")
+)
+
+// Params are the x/qgb governable parameters: the data-commitment window and
+// the unbonding-window guard. These used to be baked into compile-time
+// constants; keeping them here lets a network tune the attestation cadence
+// via a governance proposal instead of a hard fork.
+type Params struct {
+	DataCommitmentWindow uint64
+	UnbondingWindow      uint64
+}
+
+// NewParams returns a new Params instance.
+func NewParams(dataCommitmentWindow, unbondingWindow uint64) Params {
+	return Params{
+		DataCommitmentWindow: dataCommitmentWindow,
+		UnbondingWindow:      unbondingWindow,
+	}
+}
+
+// DefaultParams returns the default x/qgb params.
+func DefaultParams() Params {
+	return NewParams(DefaultDataCommitmentWindow, DefaultUnbondingWindow)
+}
+
+// ParamKeyTable returns the param key table for the x/qgb module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the paramtypes.ParamSet interface.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDataCommitmentWindow, &p.DataCommitmentWindow, validateWindow),
+		paramtypes.NewParamSetPair(KeyUnbondingWindow, &p.UnbondingWindow, validateWindow),
+	}
+}
+
+// Validate returns an error if any of the params are invalid.
+func (p Params) Validate() error {
+	if err := validateWindow(p.DataCommitmentWindow); err != nil {
+		return fmt.Errorf("invalid data commitment window: %w", err)
+	}
+	if err := validateWindow(p.UnbondingWindow); err != nil {
+		return fmt.Errorf("invalid unbonding window: %w", err)
+	}
+	return nil
+}
+
+func validateWindow(i interface{}) error {
+	window, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if window == 0 {
+		return fmt.Errorf("window must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,5 @@
+package types
+
+// EventTypeParamsUpdated is emitted when the x/qgb params are updated via
+// MsgUpdateParams.
+const EventTypeParamsUpdated = "qgb_params_updated"
@@ -0,0 +1,27 @@
+package types
+
+import "testing"
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  Params
+		wantErr bool
+	}{
+		{"default params", DefaultParams(), false},
+		{"zero data commitment window", NewParams(0, DefaultUnbondingWindow), true},
+		{"zero unbonding window", NewParams(DefaultDataCommitmentWindow, 0), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr && err == nil {
+				t.Fatalf("expected an error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}